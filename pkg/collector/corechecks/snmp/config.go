@@ -239,12 +239,12 @@ func parseScalarOids(metrics []metricsConfig, metricTags []metricTagConfig) []st
 	var oids []string
 	for _, metric := range metrics {
 		if metric.Symbol.OID != "" {
-			oids = append(oids, metric.Symbol.OID)
+			oids = append(oids, normalizeOid(metric.Symbol.OID))
 		}
 	}
 	for _, metricTag := range metricTags {
 		if metricTag.OID != "" {
-			oids = append(oids, metricTag.OID)
+			oids = append(oids, normalizeOid(metricTag.OID))
 		}
 	}
 	return oids
@@ -254,11 +254,11 @@ func parseColumnOids(metrics []metricsConfig) []string {
 	var oids []string
 	for _, metric := range metrics {
 		for _, symbol := range metric.Symbols {
-			oids = append(oids, symbol.OID)
+			oids = append(oids, normalizeOid(symbol.OID))
 		}
 		for _, metricTag := range metric.MetricTags {
 			if metricTag.Column.OID != "" {
-				oids = append(oids, metricTag.Column.OID)
+				oids = append(oids, normalizeOid(metricTag.Column.OID))
 			}
 		}
 	}
@@ -347,6 +347,27 @@ func Test_oidConfig_hasOids(t *testing.T) {
 	}
 }
 
+func Test_parseOids_leadingDot(t *testing.T) {
+	metrics := []metricsConfig{
+		{Symbol: symbolConfig{OID: ".1.3.6.1.2.1.1.3.0", Name: "sysUpTimeInstance"}},
+		{
+			Symbols: []symbolConfig{
+				{OID: ".1.3.6.1.2.1.2.2.1.2", Name: "ifDescr"},
+				{OID: "1.3.6.1.2.1.2.2.1.14", Name: "ifInErrors"},
+			},
+			MetricTags: []metricTagConfig{
+				{Tag: "interface", Column: symbolConfig{OID: ".1.3.6.1.2.1.31.1.1.1.1", Name: "ifName"}},
+			},
+		},
+	}
+	metricTags := []metricTagConfig{
+		{Tag: "snmp_host", OID: ".1.3.6.1.2.1.1.5.0", Name: "sysName"},
+	}
+
+	assert.Equal(t, []string{"1.3.6.1.2.1.1.3.0", "1.3.6.1.2.1.1.5.0"}, parseScalarOids(metrics, metricTags))
+	assert.Equal(t, []string{"1.3.6.1.2.1.2.2.1.2", "1.3.6.1.2.1.2.2.1.14", "1.3.6.1.2.1.31.1.1.1.1"}, parseColumnOids(metrics))
+}
+
 func Test_buildConfig(t *testing.T) {
 	setConfdPathAndCleanProfiles()
 
@@ -47,7 +47,7 @@ func fetchScalarOids(session sessionAPI, oids []string) (scalarResultValuesType,
 func retryFailedScalarOids(session sessionAPI, results *gosnmp.SnmpPacket, valuesToUpdate scalarResultValuesType) {
 	retryOids := make(map[string]string)
 	for _, variable := range results.Variables {
-		oid := strings.TrimLeft(variable.Name, ".")
+		oid := normalizeOid(variable.Name)
 		if (variable.Type == gosnmp.NoSuchObject || variable.Type == gosnmp.NoSuchInstance) && !strings.HasSuffix(oid, ".0") {
 			retryOids[oid] = oid + ".0"
 		}
@@ -94,6 +94,74 @@ func Test_fetchColumnOids(t *testing.T) {
 	assert.Equal(t, expectedColumnValues, columnValues)
 }
 
+func Test_fetchColumnOids_mixedLeadingDotColumns(t *testing.T) {
+	session := createMockSession()
+
+	bulkPacket := gosnmp.SnmpPacket{
+		Variables: []gosnmp.SnmpPDU{
+			{
+				Name:  ".1.3.6.1.2.1.2.2.1.14.1",
+				Type:  gosnmp.Integer,
+				Value: 141,
+			},
+			{
+				Name:  ".1.3.6.1.2.1.2.2.1.2.1",
+				Type:  gosnmp.OctetString,
+				Value: []byte("desc1"),
+			},
+			{
+				Name:  ".1.3.6.1.2.1.2.2.1.14.2",
+				Type:  gosnmp.Integer,
+				Value: 142,
+			},
+			{
+				Name:  ".1.3.6.1.2.1.2.2.1.2.2",
+				Type:  gosnmp.OctetString,
+				Value: []byte("desc2"),
+			},
+			{
+				Name:  ".1.3.6.1.2.1.2.2.1.15.1",
+				Type:  gosnmp.Integer,
+				Value: 151,
+			},
+			{
+				Name:  ".1.3.6.1.2.1.2.2.1.3.1",
+				Type:  gosnmp.Integer,
+				Value: 31,
+			},
+		},
+	}
+	session.On("GetBulk", []string{"1.3.6.1.2.1.2.2.1.14", "1.3.6.1.2.1.2.2.1.2"}).Return(&bulkPacket, nil)
+
+	metrics := []metricsConfig{
+		{
+			Symbols: []symbolConfig{
+				{OID: ".1.3.6.1.2.1.2.2.1.2", Name: "ifDescr"},
+				{OID: "1.3.6.1.2.1.2.2.1.14", Name: "ifInErrors"},
+			},
+		},
+	}
+	oids := make(map[string]string)
+	for _, oid := range parseColumnOids(metrics) {
+		oids[oid] = oid
+	}
+
+	columnValues, err := fetchColumnOidsWithBatching(session, oids, 100)
+	assert.Nil(t, err)
+
+	expectedColumnValues := columnResultValuesType{
+		"1.3.6.1.2.1.2.2.1.14": {
+			"1": snmpValueType{value: float64(141)},
+			"2": snmpValueType{value: float64(142)},
+		},
+		"1.3.6.1.2.1.2.2.1.2": {
+			"1": snmpValueType{value: "desc1"},
+			"2": snmpValueType{value: "desc2"},
+		},
+	}
+	assert.Equal(t, expectedColumnValues, columnValues)
+}
+
 func Test_fetchColumnOidsBatch_usingGetBulk(t *testing.T) {
 	session := createMockSession()
 
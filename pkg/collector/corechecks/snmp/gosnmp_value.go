@@ -3,6 +3,7 @@ package snmp
 import (
 	"fmt"
 	"math"
+	"math/big"
 	"strings"
 
 	"github.com/gosnmp/gosnmp"
@@ -18,7 +19,7 @@ import (
 // - gosnmp.Boolean: seems not exist anymore and not handled by gosnmp
 func getValueFromPDU(pduVariable gosnmp.SnmpPDU) (string, snmpValueType, error) {
 	var value interface{}
-	name := strings.TrimLeft(pduVariable.Name, ".") // remove leading dot
+	name := normalizeOid(pduVariable.Name)
 	switch pduVariable.Type {
 	case gosnmp.OctetString, gosnmp.BitString:
 		bytesValue, ok := pduVariable.Value.([]byte)
@@ -36,7 +37,9 @@ func getValueFromPDU(pduVariable gosnmp.SnmpPDU) (string, snmpValueType, error)
 			value = string(bytesValue)
 		}
 	case gosnmp.Integer, gosnmp.Counter32, gosnmp.Gauge32, gosnmp.TimeTicks, gosnmp.Counter64, gosnmp.Uinteger32:
-		value = float64(gosnmp.ToBigInt(pduVariable.Value).Int64())
+		// Counter64 values can be greater than math.MaxInt64, converting them with `big.Int.Int64()` would overflow.
+		// The conversion to float64 is exact up to 2^53, greater values are rounded to the nearest float64.
+		value, _ = new(big.Float).SetInt(gosnmp.ToBigInt(pduVariable.Value)).Float64()
 	case gosnmp.OpaqueFloat:
 		floatValue, ok := pduVariable.Value.(float32)
 		if !ok {
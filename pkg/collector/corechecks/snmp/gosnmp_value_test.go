@@ -2,6 +2,7 @@ package snmp
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/gosnmp/gosnmp"
@@ -170,6 +171,17 @@ func Test_getValueFromPDU(t *testing.T) {
 			snmpValueType{submissionType: "counter", value: float64(10)},
 			nil,
 		},
+		{
+			"Counter64 greater than MaxInt64",
+			gosnmp.SnmpPDU{
+				Name:  ".1.2.3",
+				Type:  gosnmp.Counter64,
+				Value: uint64(math.MaxUint64),
+			},
+			"1.2.3",
+			snmpValueType{submissionType: "counter", value: float64(math.MaxUint64)},
+			nil,
+		},
 		{
 			"Uinteger32",
 			gosnmp.SnmpPDU{
@@ -1,6 +1,9 @@
 package snmp
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 func createStringBatches(elements []string, size int) ([][]string, error) {
 	var batches [][]string
@@ -26,3 +29,9 @@ func copyStrings(tags []string) []string {
 	copy(newTags, tags)
 	return newTags
 }
+
+// normalizeOid removes the leading dot of an oid, oids are always stored without it.
+// This makes lookups work with both `1.3.6.1.2.1.1.1.0` and `.1.3.6.1.2.1.1.1.0` forms.
+func normalizeOid(oid string) string {
+	return strings.TrimLeft(oid, ".")
+}
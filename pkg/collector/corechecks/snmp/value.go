@@ -2,8 +2,10 @@ package snmp
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
+	"strings"
 )
 
 type snmpValueType struct {
@@ -11,34 +13,53 @@ type snmpValueType struct {
 	value          interface{} // might be a `string` or `float64` type
 }
 
+// toFloat64 converts the value to float64.
+// Integer values (including Counter64) are stored as float64 by getValueFromPDU,
+// they are exact up to 2^53 and approximate above. We don't flag the approximation since metrics
+// are submitted to the aggregator as float64 anyway, and the relative error (at most 2^-53) is negligible.
 func (sv *snmpValueType) toFloat64() (float64, error) {
-	switch sv.value.(type) {
+	switch value := sv.value.(type) {
 	case float64:
-		return sv.value.(float64), nil
+		return value, nil
 	case string:
-		val, err := strconv.ParseInt(sv.value.(string), 10, 64)
+		strValue := strings.TrimSpace(value)
+		// ParseFloat also accepts Go literal syntax such as underscores (`1_000`) and hex floats (`0x1p4`),
+		// only decimal and scientific notation are valid here
+		if strings.ContainsAny(strValue, "_xX") {
+			return 0, fmt.Errorf("failed to parse `%s`: not a decimal number", sv.value)
+		}
+		// ParseFloat handles plain integers as well as decimal and scientific notation (e.g. `3.14`, `1.5e3`)
+		val, err := strconv.ParseFloat(strValue, 64)
 		if err != nil {
 			return 0, fmt.Errorf("failed to parse `%s`: %s", sv.value, err.Error())
 		}
-		return float64(val), nil
+		// ParseFloat also accepts `NaN` and `Inf`, those are not valid metric values
+		if math.IsNaN(val) || math.IsInf(val, 0) {
+			return 0, fmt.Errorf("failed to parse `%s`: not a finite number", sv.value)
+		}
+		return val, nil
 	}
 	return 0, fmt.Errorf("invalid type %T for value %#v", sv.value, sv.value)
 }
 
+// toString converts the value to string, float64 values are truncated to integers.
 func (sv snmpValueType) toString() (string, error) {
-	switch sv.value.(type) {
+	switch value := sv.value.(type) {
 	case float64:
-		return strconv.Itoa(int(sv.value.(float64))), nil
+		// Counter64 values can be greater than math.MaxInt64, converting them to int64 would overflow
+		if value >= math.MinInt64 && value < math.MaxInt64 {
+			return strconv.FormatInt(int64(value), 10), nil
+		}
+		return strconv.FormatFloat(value, 'f', 0, 64), nil
 	case string:
-		return sv.value.(string), nil
+		return value, nil
 	}
 	return "", fmt.Errorf("invalid type %T for value %#v", sv.value, sv.value)
 }
 
 func (sv snmpValueType) extractStringValue(extractValuePattern *regexp.Regexp) (snmpValueType, error) {
-	switch sv.value.(type) {
+	switch srcValue := sv.value.(type) {
 	case string:
-		srcValue := sv.value.(string)
 		matches := extractValuePattern.FindStringSubmatch(srcValue)
 		if matches == nil {
 			return snmpValueType{}, fmt.Errorf("extract value extractValuePattern does not match (extractValuePattern=%v, srcValue=%v)", extractValuePattern, srcValue)
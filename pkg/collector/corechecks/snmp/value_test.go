@@ -0,0 +1,185 @@
+package snmp
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_snmpValueType_toFloat64(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         interface{}
+		expectedValue float64
+		expectedErr   error
+	}{
+		{
+			"float64",
+			float64(10),
+			float64(10),
+			nil,
+		},
+		{
+			"integer string",
+			"42",
+			float64(42),
+			nil,
+		},
+		{
+			"decimal string",
+			"3.14",
+			float64(3.14),
+			nil,
+		},
+		{
+			"negative decimal string",
+			"-1.5",
+			float64(-1.5),
+			nil,
+		},
+		{
+			"scientific notation string",
+			"1e2",
+			float64(100),
+			nil,
+		},
+		{
+			"string with surrounding spaces",
+			" 42 ",
+			float64(42),
+			nil,
+		},
+		{
+			"invalid string",
+			"abc",
+			float64(0),
+			fmt.Errorf("failed to parse `abc`: strconv.ParseFloat: parsing \"abc\": invalid syntax"),
+		},
+		{
+			"string with underscores",
+			"1_000",
+			float64(0),
+			fmt.Errorf("failed to parse `1_000`: not a decimal number"),
+		},
+		{
+			"hex float string",
+			"0x1p4",
+			float64(0),
+			fmt.Errorf("failed to parse `0x1p4`: not a decimal number"),
+		},
+		{
+			"negative uppercase hex float string",
+			"-0X1P4",
+			float64(0),
+			fmt.Errorf("failed to parse `-0X1P4`: not a decimal number"),
+		},
+		{
+			"hexified OctetString",
+			"0x00249b3503f6",
+			float64(0),
+			fmt.Errorf("failed to parse `0x00249b3503f6`: not a decimal number"),
+		},
+		{
+			"NaN string",
+			"NaN",
+			float64(0),
+			fmt.Errorf("failed to parse `NaN`: not a finite number"),
+		},
+		{
+			"Inf string",
+			"-Inf",
+			float64(0),
+			fmt.Errorf("failed to parse `-Inf`: not a finite number"),
+		},
+		{
+			"invalid type",
+			[]byte{0x01},
+			float64(0),
+			fmt.Errorf("invalid type []uint8 for value []byte{0x1}"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := snmpValueType{value: tt.value}
+			value, err := sv.toFloat64()
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedValue, value)
+		})
+	}
+}
+
+func Test_snmpValueType_toString(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         interface{}
+		expectedValue string
+		expectedErr   error
+	}{
+		{
+			"float64",
+			float64(10),
+			"10",
+			nil,
+		},
+		{
+			"float64 is truncated",
+			float64(3.7),
+			"3",
+			nil,
+		},
+		{
+			"negative float64",
+			float64(-10),
+			"-10",
+			nil,
+		},
+		{
+			// float64(math.MaxUint64) is rounded to 2^64
+			"float64 greater than MaxInt64",
+			float64(math.MaxUint64),
+			"18446744073709551616",
+			nil,
+		},
+		{
+			"string",
+			"abc",
+			"abc",
+			nil,
+		},
+		{
+			"invalid type",
+			[]byte{0x01},
+			"",
+			fmt.Errorf("invalid type []uint8 for value []byte{0x1}"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := snmpValueType{value: tt.value}
+			value, err := sv.toString()
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedValue, value)
+		})
+	}
+}
+
+func Benchmark_snmpValueType_toFloat64(b *testing.B) {
+	values := make(map[string]snmpValueType, 5000)
+	for i := 0; i < 5000; i++ {
+		oid := fmt.Sprintf("1.3.6.1.2.1.2.2.1.10.%d", i)
+		if i%2 == 0 {
+			values[oid] = snmpValueType{value: float64(i)}
+		} else {
+			values[oid] = snmpValueType{value: fmt.Sprintf("%d", i)}
+		}
+	}
+	b.ResetTimer()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		for _, value := range values {
+			_, _ = value.toFloat64()
+		}
+	}
+}
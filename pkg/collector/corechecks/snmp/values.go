@@ -4,6 +4,9 @@ import (
 	"fmt"
 )
 
+// resultValueStore holds the values fetched during a check run.
+// It's fully populated by fetchValues before being returned and must be treated as read-only afterwards,
+// that's why it doesn't need any locking.
 type resultValueStore struct {
 	scalarValues scalarResultValuesType
 	columnValues columnResultValuesType
@@ -12,6 +15,7 @@ type resultValueStore struct {
 // getScalarValue look for oid in resultValueStore and returns the value and boolean
 // weather valid value has been found
 func (v *resultValueStore) getScalarValue(oid string) (snmpValueType, error) {
+	oid = normalizeOid(oid)
 	value, ok := v.scalarValues[oid]
 	if !ok {
 		return snmpValueType{}, fmt.Errorf("value for Scalar OID `%s` not found in `%v`", oid, v.scalarValues)
@@ -24,6 +28,7 @@ func (v *resultValueStore) getScalarValue(oid string) (snmpValueType, error) {
 // For example if the row oid (instance oid) is `1.3.6.1.4.1.1.2.3.10.11.12`,
 // the column oid is `1.3.6.1.4.1.1.2.3`, the fullIndex is `10.11.12`.
 func (v *resultValueStore) getColumnValues(oid string) (map[string]snmpValueType, error) {
+	oid = normalizeOid(oid)
 	values, ok := v.columnValues[oid]
 	if !ok {
 		return nil, fmt.Errorf("value for Column OID `%s` not found in `%v`", oid, v.columnValues)
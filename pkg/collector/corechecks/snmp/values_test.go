@@ -0,0 +1,32 @@
+package snmp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_resultValueStore_leadingDotOid(t *testing.T) {
+	store := &resultValueStore{
+		scalarResultValuesType{
+			"1.3.6.1.2.1.1.3.0": snmpValueType{value: float64(10)},
+		},
+		columnResultValuesType{
+			"1.3.6.1.2.1.2.2.1.14": {
+				"1": snmpValueType{value: float64(141)},
+			},
+		},
+	}
+
+	for _, oid := range []string{"1.3.6.1.2.1.1.3.0", ".1.3.6.1.2.1.1.3.0"} {
+		value, err := store.getScalarValue(oid)
+		assert.Nil(t, err)
+		assert.Equal(t, snmpValueType{value: float64(10)}, value)
+	}
+
+	for _, oid := range []string{"1.3.6.1.2.1.2.2.1.14", ".1.3.6.1.2.1.2.2.1.14"} {
+		values, err := store.getColumnValues(oid)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]snmpValueType{"1": {value: float64(141)}}, values)
+	}
+}